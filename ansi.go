@@ -0,0 +1,163 @@
+package escpos
+
+import (
+	"io"
+	"strconv"
+)
+
+// ansiState is the small state machine used by ANSIWriter to recognize CSI
+// escape sequences across Write calls: ground -> esc -> csiParam -> (applied, back to ground).
+type ansiState int
+
+const (
+	ansiGround ansiState = iota
+	ansiEsc
+	ansiCSIParam
+)
+
+// ANSIWriter translates a byte stream containing ANSI CSI escape sequences
+// (as produced by terminal-oriented programs) into ESC/POS state changes on
+// the wrapped Escpos, so existing terminal output can be rendered on a
+// receipt printer without rewriting formatting code.
+type ANSIWriter struct {
+	e     *Escpos
+	state ansiState
+	param []byte // accumulated parameter bytes of the in-progress sequence
+}
+
+// NewANSIWriter wraps e so that ANSI CSI sequences written to the returned
+// io.Writer are applied as state changes on e, and all other bytes are
+// passed through to e.Write.
+func NewANSIWriter(e *Escpos) io.Writer {
+	return &ANSIWriter{e: e}
+}
+
+// Write implements io.Writer.
+func (w *ANSIWriter) Write(p []byte) (int, error) {
+	start := 0
+	flush := func(end int) {
+		if end > start {
+			w.e.Write(string(p[start:end]))
+		}
+	}
+
+	for i := 0; i < len(p); i++ {
+		b := p[i]
+
+		switch w.state {
+		case ansiGround:
+			switch b {
+			case 0x1b: // ESC
+				flush(i)
+				start = i + 1
+				w.state = ansiEsc
+			case '\r':
+				flush(i)
+				w.e.Write("\r")
+				start = i + 1
+			case '\f':
+				flush(i)
+				w.e.Cut()
+				start = i + 1
+			}
+
+		case ansiEsc:
+			if b == '[' {
+				w.param = w.param[:0]
+				start = i + 1
+				w.state = ansiCSIParam
+			} else {
+				// not a CSI sequence we understand, drop the ESC and this byte and resume
+				start = i + 1
+				w.state = ansiGround
+			}
+
+		case ansiCSIParam:
+			switch {
+			case b >= '0' && b <= '9' || b == ';':
+				w.param = append(w.param, b)
+			default:
+				w.applyCSI(b, w.param)
+				start = i + 1
+				w.state = ansiGround
+			}
+		}
+	}
+
+	if w.state == ansiGround {
+		flush(len(p))
+	}
+	return len(p), nil
+}
+
+// applyCSI handles one complete `ESC [ params final` sequence.
+func (w *ANSIWriter) applyCSI(final byte, params []byte) {
+	switch final {
+	case 'm':
+		for _, p := range splitCSIParams(params) {
+			w.applySGR(p)
+		}
+	case 'A', 'B':
+		n := firstCSIParam(params, 1)
+		w.e.sendMoveY(uint16(n))
+	case 'C':
+		n := firstCSIParam(params, 1)
+		w.e.sendMoveX(uint16(n))
+	case 'D':
+		// no absolute "move left" primitive exists; best effort via Linefeed
+		w.e.Linefeed()
+	}
+}
+
+// applySGR applies one `m`-terminated SGR parameter.
+func (w *ANSIWriter) applySGR(p int) {
+	switch p {
+	case 0:
+		w.e.Init()
+	case 1:
+		w.e.SetEmphasize(1)
+	case 22:
+		w.e.SetEmphasize(0)
+	case 4:
+		w.e.SetUnderline(1)
+	case 24:
+		w.e.SetUnderline(0)
+	case 7:
+		w.e.SetReverse(1)
+	case 27:
+		w.e.SetReverse(0)
+	case 53:
+		// overline: no ESC/POS equivalent, best-effort no-op
+	case 10:
+		w.e.SetFont("A")
+	case 11:
+		w.e.SetFont("B")
+	}
+}
+
+func splitCSIParams(params []byte) []int {
+	if len(params) == 0 {
+		return []int{0}
+	}
+	var out []int
+	start := 0
+	for i := 0; i <= len(params); i++ {
+		if i == len(params) || params[i] == ';' {
+			if i == start {
+				out = append(out, 0)
+			} else if n, err := strconv.Atoi(string(params[start:i])); err == nil {
+				out = append(out, n)
+			}
+			start = i + 1
+		}
+	}
+	return out
+}
+
+func firstCSIParam(params []byte, def int) int {
+	parts := splitCSIParams(params)
+	if len(parts) == 0 || parts[0] == 0 {
+		return def
+	}
+	return parts[0]
+}
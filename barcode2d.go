@@ -0,0 +1,181 @@
+package escpos
+
+import (
+	"fmt"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// QRModel selects the GS ( k model byte for PrintQRCode. Values are the
+// fn=65 n1 parameter bytes (49-51), not the model numbers 1-3.
+type QRModel int
+
+const (
+	QRModel1     QRModel = 49
+	QRModel2     QRModel = 50
+	QRModelMicro QRModel = 51
+)
+
+// QRECC selects the QR error correction level. Values are the GS ( k fn=69
+// parameter bytes (48-51), not the ASCII level letters.
+type QRECC byte
+
+const (
+	QRECCL QRECC = 48 + iota
+	QRECCM
+	QRECCQ
+	QRECCH
+)
+
+// QRFallback selects how PrintQRCode renders the code.
+type QRFallback int
+
+const (
+	// QRAuto tries the native GS ( k command family; callers that know
+	// their printer's firmware lacks 2D barcode support should use QRRaster.
+	QRAuto QRFallback = iota
+	// QRNative always uses the GS ( k command family.
+	QRNative
+	// QRRaster always rasterizes the code in software and prints it via the
+	// raster image path, for printers whose firmware has no GS ( k support.
+	QRRaster
+)
+
+// QROptions configures PrintQRCode.
+type QROptions struct {
+	Model      QRModel
+	ModuleSize int // 1-16
+	ECC        QRECC
+	Align      string // "left", "center", "right"
+	Fallback   QRFallback
+}
+
+func (o QROptions) withDefaults() QROptions {
+	if o.Model == 0 {
+		o.Model = QRModel2
+	}
+	if o.ModuleSize == 0 {
+		o.ModuleSize = 4
+	}
+	if o.ECC == 0 {
+		o.ECC = QRECCM
+	}
+	return o
+}
+
+// gsk sends one GS ( k function: GS ( k pL pH cn fn [params...].
+func (e *Escpos) gsk(cn byte, fn byte, params []byte) {
+	l := len(params) + 2
+	e.WriteRaw([]byte{0x1D, '(', 'k', byte(l % 256), byte(l / 256), cn, fn})
+	e.WriteRaw(params)
+}
+
+// PrintQRCode prints data as a QR code. By default (QRAuto) it uses the
+// native GS ( k command family; set opts.Fallback = QRRaster to rasterize
+// the code in software (via go-qrcode) and send it through the raster image
+// path instead, for printers whose firmware lacks native QR support.
+func (e *Escpos) PrintQRCode(data string, opts QROptions) error {
+	opts = opts.withDefaults()
+
+	if opts.Align != "" {
+		e.SetAlign(opts.Align)
+	}
+
+	if opts.Fallback == QRRaster {
+		return e.printQRRaster(data, opts)
+	}
+
+	// model select: GS ( k 04 00 31 41 n1 n2
+	e.gsk(0x31, 0x41, []byte{byte(opts.Model), 0x00})
+	// module size: GS ( k 03 00 31 43 n
+	e.gsk(0x31, 0x43, []byte{byte(opts.ModuleSize)})
+	// error correction level: GS ( k 03 00 31 45 n
+	e.gsk(0x31, 0x45, []byte{byte(opts.ECC)})
+	// store data: GS ( k pL pH 31 50 30 d1..dk
+	e.gsk(0x31, 0x50, append([]byte{0x30}, []byte(data)...))
+	// print: GS ( k 03 00 31 51 30
+	e.gsk(0x31, 0x51, []byte{0x30})
+
+	return nil
+}
+
+// printQRRaster rasterizes data as a QR code in software and prints it via
+// the existing raster image path, for QRFallback == QRRaster or when a
+// caller has determined native support is unavailable.
+func (e *Escpos) printQRRaster(data string, opts QROptions) error {
+	ecc := qrcode.Medium
+	switch opts.ECC {
+	case QRECCL:
+		ecc = qrcode.Low
+	case QRECCQ:
+		ecc = qrcode.High
+	case QRECCH:
+		ecc = qrcode.Highest
+	}
+
+	q, err := qrcode.New(data, ecc)
+	if err != nil {
+		return fmt.Errorf("escpos: PrintQRCode raster fallback: %w", err)
+	}
+
+	img := q.Image(opts.ModuleSize * 25)
+	e.PrintImage(img)
+	return nil
+}
+
+// PDF417Options configures PrintPDF417.
+type PDF417Options struct {
+	Columns    int // 0 lets the printer choose
+	Rows       int // 0 lets the printer choose
+	ModuleSize int // 1-8, defaults to 3
+	ECC        int // 1-8, defaults to 1; 0 selects the default, so level 0 cannot be set
+	Align      string
+}
+
+// PrintPDF417 prints data as a PDF417 barcode using the GS ( k command
+// family.
+func (e *Escpos) PrintPDF417(data string, opts PDF417Options) error {
+	if opts.ModuleSize == 0 {
+		opts.ModuleSize = 3
+	}
+	if opts.ECC == 0 {
+		opts.ECC = 1
+	}
+	if opts.Align != "" {
+		e.SetAlign(opts.Align)
+	}
+
+	e.gsk(0x30, 0x41, []byte{byte(opts.Columns)})            // number of columns
+	e.gsk(0x30, 0x42, []byte{byte(opts.Rows)})               // number of rows
+	e.gsk(0x30, 0x43, []byte{byte(opts.ModuleSize)})         // module width
+	e.gsk(0x30, 0x45, []byte{0x30, byte(48 + opts.ECC)})     // error correction level: m=30 (set by level), n=48+level
+	e.gsk(0x30, 0x50, append([]byte{0x30}, []byte(data)...)) // store data
+	e.gsk(0x30, 0x51, []byte{0x30})                          // print
+
+	return nil
+}
+
+// DataMatrixOptions configures PrintDataMatrix.
+type DataMatrixOptions struct {
+	ModuleSize int // defaults to 3
+	Align      string
+}
+
+// PrintDataMatrix prints data as a DataMatrix barcode using the GS ( k
+// command family.
+func (e *Escpos) PrintDataMatrix(data string, opts DataMatrixOptions) error {
+	if opts.ModuleSize == 0 {
+		opts.ModuleSize = 3
+	}
+	if opts.Align != "" {
+		e.SetAlign(opts.Align)
+	}
+
+	// cn = 0x36 selects Data Matrix; 0x32 is MaxiCode, a different symbology
+	// in the GS ( k family and must not be reused here.
+	e.gsk(0x36, 0x43, []byte{byte(opts.ModuleSize)})         // module size
+	e.gsk(0x36, 0x50, append([]byte{0x30}, []byte(data)...)) // store data
+	e.gsk(0x36, 0x51, []byte{0x30})                          // print
+
+	return nil
+}
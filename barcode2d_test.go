@@ -0,0 +1,74 @@
+package escpos
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrintQRCode(t *testing.T) {
+	var buf bytes.Buffer
+	e := New(&buf)
+
+	opts := QROptions{
+		Model:      QRModel1,
+		ModuleSize: 4,
+		ECC:        QRECCL,
+		Fallback:   QRNative,
+	}
+	if err := e.PrintQRCode("A", opts); err != nil {
+		t.Fatalf("PrintQRCode: %v", err)
+	}
+
+	want := []byte{
+		0x1D, '(', 'k', 0x04, 0x00, 0x31, 0x41, 0x31, 0x00, // model select: model 1 (49)
+		0x1D, '(', 'k', 0x03, 0x00, 0x31, 0x43, 0x04, // module size: 4
+		0x1D, '(', 'k', 0x03, 0x00, 0x31, 0x45, 0x30, // ECC: L (48)
+		0x1D, '(', 'k', 0x04, 0x00, 0x31, 0x50, 0x30, 'A', // store data
+		0x1D, '(', 'k', 0x03, 0x00, 0x31, 0x51, 0x30, // print
+	}
+
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Fatalf("PrintQRCode bytes =\n% X\nwant\n% X", got, want)
+	}
+}
+
+func TestPrintPDF417(t *testing.T) {
+	var buf bytes.Buffer
+	e := New(&buf)
+
+	if err := e.PrintPDF417("A", PDF417Options{}); err != nil {
+		t.Fatalf("PrintPDF417: %v", err)
+	}
+
+	want := []byte{
+		0x1D, '(', 'k', 0x03, 0x00, 0x30, 0x41, 0x00, // columns: printer chooses
+		0x1D, '(', 'k', 0x03, 0x00, 0x30, 0x42, 0x00, // rows: printer chooses
+		0x1D, '(', 'k', 0x03, 0x00, 0x30, 0x43, 0x03, // module size: default 3
+		0x1D, '(', 'k', 0x04, 0x00, 0x30, 0x45, 0x30, 0x31, // ECC: set-by-level, level 1 (default)
+		0x1D, '(', 'k', 0x04, 0x00, 0x30, 0x50, 0x30, 'A', // store data
+		0x1D, '(', 'k', 0x03, 0x00, 0x30, 0x51, 0x30, // print
+	}
+
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Fatalf("PrintPDF417 bytes =\n% X\nwant\n% X", got, want)
+	}
+}
+
+func TestPrintDataMatrix(t *testing.T) {
+	var buf bytes.Buffer
+	e := New(&buf)
+
+	if err := e.PrintDataMatrix("A", DataMatrixOptions{}); err != nil {
+		t.Fatalf("PrintDataMatrix: %v", err)
+	}
+
+	want := []byte{
+		0x1D, '(', 'k', 0x03, 0x00, 0x36, 0x43, 0x03, // module size: default 3
+		0x1D, '(', 'k', 0x04, 0x00, 0x36, 0x50, 0x30, 'A', // store data
+		0x1D, '(', 'k', 0x03, 0x00, 0x36, 0x51, 0x30, // print
+	}
+
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Fatalf("PrintDataMatrix bytes =\n% X\nwant\n% X", got, want)
+	}
+}
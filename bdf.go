@@ -0,0 +1,304 @@
+package escpos
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Glyph is a single rasterized character loaded from a BDF font.
+type Glyph struct {
+	Width, Height          int
+	BBXOffsetX, BBXOffsetY int
+	DWidth                 int
+	Bits                   []byte // one row per Height, padded to byte width
+}
+
+// BDFFont is a bitmap font parsed from the Adobe BDF format.
+type BDFFont struct {
+	Name          string
+	Width, Height int // FONTBOUNDINGBOX
+	XOff, YOff    int
+	Ascent        int // FONT_ASCENT, defaults to Height if absent
+	Descent       int // FONT_DESCENT
+	Glyphs        map[rune]Glyph
+}
+
+// LoadBDFFont reads and parses a .bdf font file from disk.
+func LoadBDFFont(path string) (*BDFFont, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseBDFFont(f)
+}
+
+// ParseBDFFont parses a BDF font from r.
+func ParseBDFFont(r io.Reader) (*BDFFont, error) {
+	font := &BDFFont{Glyphs: make(map[rune]Glyph)}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var (
+		inChar   bool
+		inBitmap bool
+		encoding rune
+		bbw, bbh int
+		bbxOff   int
+		bbyOff   int
+		dwidth   int
+		rows     []string
+	)
+
+	flushGlyph := func() {
+		if encoding < 0 {
+			return
+		}
+		rowBytes := (bbw + 7) / 8
+		bits := make([]byte, rowBytes*bbh)
+		for i, row := range rows {
+			if i >= bbh {
+				break
+			}
+			raw, err := hex.DecodeString(padHex(row, rowBytes*2))
+			if err != nil {
+				continue
+			}
+			copy(bits[i*rowBytes:(i+1)*rowBytes], raw)
+		}
+		font.Glyphs[encoding] = Glyph{
+			Width:      bbw,
+			Height:     bbh,
+			BBXOffsetX: bbxOff,
+			BBXOffsetY: bbyOff,
+			DWidth:     dwidth,
+			Bits:       bits,
+		}
+	}
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		keyword := fields[0]
+
+		switch {
+		case keyword == "STARTFONT":
+			// nothing to do, just a version marker
+
+		case keyword == "FONT" && !inChar:
+			font.Name = strings.TrimPrefix(line, "FONT ")
+
+		case keyword == "FONTBOUNDINGBOX":
+			font.Width, _ = strconv.Atoi(fields[1])
+			font.Height, _ = strconv.Atoi(fields[2])
+			font.XOff, _ = strconv.Atoi(fields[3])
+			font.YOff, _ = strconv.Atoi(fields[4])
+
+		case keyword == "FONT_ASCENT":
+			font.Ascent, _ = strconv.Atoi(fields[1])
+
+		case keyword == "FONT_DESCENT":
+			font.Descent, _ = strconv.Atoi(fields[1])
+
+		case keyword == "STARTCHAR":
+			inChar = true
+			inBitmap = false
+			encoding = -1
+			bbw, bbh, bbxOff, bbyOff, dwidth = font.Width, font.Height, 0, 0, font.Width
+			rows = rows[:0]
+
+		case keyword == "ENCODING" && inChar:
+			code, err := strconv.Atoi(fields[1])
+			if err == nil && code >= 0 {
+				encoding = rune(code)
+			}
+
+		case keyword == "DWIDTH" && inChar:
+			dwidth, _ = strconv.Atoi(fields[1])
+
+		case keyword == "BBX" && inChar:
+			bbw, _ = strconv.Atoi(fields[1])
+			bbh, _ = strconv.Atoi(fields[2])
+			bbxOff, _ = strconv.Atoi(fields[3])
+			bbyOff, _ = strconv.Atoi(fields[4])
+
+		case keyword == "BITMAP" && inChar:
+			inBitmap = true
+
+		case keyword == "ENDCHAR":
+			flushGlyph()
+			inChar = false
+			inBitmap = false
+
+		case inBitmap:
+			rows = append(rows, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if font.Ascent == 0 {
+		font.Ascent = font.Height
+	}
+
+	return font, nil
+}
+
+// padHex right-pads a hex row with zeroes so it decodes to exactly n/2 bytes.
+func padHex(row string, n int) string {
+	if len(row) >= n {
+		return row[:n]
+	}
+	return row + strings.Repeat("0", n-len(row))
+}
+
+// PrintTextBDFOption configures PrintTextBDF.
+type PrintTextBDFOption func(*bdfTextConfig)
+
+type bdfTextConfig struct {
+	align        string
+	lineSpacing  int
+	maxWidthDots int
+}
+
+// BDFAlign sets the horizontal alignment ("left", "center", "right").
+func BDFAlign(align string) PrintTextBDFOption {
+	return func(c *bdfTextConfig) { c.align = align }
+}
+
+// BDFLineSpacing sets the extra vertical gap in dots between lines.
+func BDFLineSpacing(dots int) PrintTextBDFOption {
+	return func(c *bdfTextConfig) { c.lineSpacing = dots }
+}
+
+// BDFMaxWidth caps the raster width in dots, wrapping text that exceeds it.
+func BDFMaxWidth(dots int) PrintTextBDFOption {
+	return func(c *bdfTextConfig) { c.maxWidthDots = dots }
+}
+
+// PrintTextBDF rasterizes s using font and prints it as an ESC/POS raster
+// image. This allows pixel-perfect, code-page-independent typography
+// (e.g. CJK glyphs) without relying on the printer's built-in fonts or
+// WriteGBK-style iconv conversion.
+func (e *Escpos) PrintTextBDF(font *BDFFont, s string, opts ...PrintTextBDFOption) error {
+	cfg := &bdfTextConfig{align: "left"}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	lines := strings.Split(s, "\n")
+	if cfg.maxWidthDots > 0 {
+		lines = wrapBDFLines(font, lines, cfg.maxWidthDots)
+	}
+
+	lineHeight := font.Height + cfg.lineSpacing
+	lineWidths := make([]int, len(lines))
+	maxWidth := 0
+	for i, line := range lines {
+		w := measureBDFLine(font, line)
+		lineWidths[i] = w
+		if w > maxWidth {
+			maxWidth = w
+		}
+	}
+	if maxWidth == 0 {
+		return fmt.Errorf("escpos: PrintTextBDF: nothing to render")
+	}
+
+	img := image.NewGray(image.Rect(0, 0, maxWidth, len(lines)*lineHeight))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+
+	for i, line := range lines {
+		x := 0
+		switch cfg.align {
+		case "center":
+			x = (maxWidth - lineWidths[i]) / 2
+		case "right":
+			x = maxWidth - lineWidths[i]
+		}
+		drawBDFLine(img, font, line, x, i*lineHeight)
+	}
+
+	e.PrintImage(img)
+	return nil
+}
+
+func measureBDFLine(font *BDFFont, line string) int {
+	width := 0
+	for _, r := range line {
+		if g, ok := font.Glyphs[r]; ok {
+			width += g.DWidth
+		} else {
+			width += font.Width
+		}
+	}
+	return width
+}
+
+func wrapBDFLines(font *BDFFont, lines []string, maxWidthDots int) []string {
+	var out []string
+	for _, line := range lines {
+		cur := strings.Builder{}
+		curWidth := 0
+		for _, r := range line {
+			g, ok := font.Glyphs[r]
+			adv := font.Width
+			if ok {
+				adv = g.DWidth
+			}
+			if curWidth+adv > maxWidthDots && cur.Len() > 0 {
+				out = append(out, cur.String())
+				cur.Reset()
+				curWidth = 0
+			}
+			cur.WriteRune(r)
+			curWidth += adv
+		}
+		out = append(out, cur.String())
+	}
+	return out
+}
+
+// drawBDFLine composes line onto img at (x, y), with y being the top of the
+// line's bounding box; glyphs are baseline-aligned using font.Ascent.
+func drawBDFLine(img *image.Gray, font *BDFFont, line string, x, y int) {
+	cursor := x
+	for _, r := range line {
+		g, ok := font.Glyphs[r]
+		if !ok {
+			cursor += font.Width
+			continue
+		}
+		rowBytes := (g.Width + 7) / 8
+		baseline := y + font.Ascent
+		top := baseline - g.Height - g.BBXOffsetY
+		for row := 0; row < g.Height; row++ {
+			for col := 0; col < g.Width; col++ {
+				b := g.Bits[row*rowBytes+col/8]
+				if b&(0x80>>uint(col%8)) == 0 {
+					continue
+				}
+				px := cursor + g.BBXOffsetX + col
+				py := top + row
+				if px >= 0 && px < img.Bounds().Dx() && py >= 0 && py < img.Bounds().Dy() {
+					img.SetGray(px, py, color.Gray{Y: 0})
+				}
+			}
+		}
+		cursor += g.DWidth
+	}
+}
@@ -0,0 +1,60 @@
+package escpos
+
+import (
+	"strings"
+	"testing"
+)
+
+const testBDF = `STARTFONT 2.1
+FONT -test-test-Medium-R-Normal--8-80-75-75-P-50-ISO10646-1
+SIZE 8 75 75
+FONTBOUNDINGBOX 8 8 0 0
+STARTPROPERTIES 1
+FONT_ASCENT 7
+ENDPROPERTIES
+CHARS 1
+STARTCHAR A
+ENCODING 65
+SWIDTH 500 0
+DWIDTH 8 0
+BBX 8 8 0 0
+BITMAP
+FF
+00
+FF
+00
+FF
+00
+FF
+00
+ENDCHAR
+ENDFONT
+`
+
+func TestParseBDFFont(t *testing.T) {
+	font, err := ParseBDFFont(strings.NewReader(testBDF))
+	if err != nil {
+		t.Fatalf("ParseBDFFont: %v", err)
+	}
+
+	if font.Width != 8 || font.Height != 8 {
+		t.Fatalf("font bounding box = %dx%d, want 8x8", font.Width, font.Height)
+	}
+	if font.Ascent != 7 {
+		t.Fatalf("font.Ascent = %d, want 7", font.Ascent)
+	}
+
+	g, ok := font.Glyphs['A']
+	if !ok {
+		t.Fatalf("glyph 'A' not parsed")
+	}
+	if g.Width != 8 || g.Height != 8 || g.DWidth != 8 {
+		t.Fatalf("glyph 'A' = %+v, want Width=8 Height=8 DWidth=8", g)
+	}
+	if len(g.Bits) != 8 {
+		t.Fatalf("glyph 'A' Bits len = %d, want 8", len(g.Bits))
+	}
+	if g.Bits[0] != 0xFF || g.Bits[1] != 0x00 {
+		t.Fatalf("glyph 'A' Bits = % X, want rows starting FF 00", g.Bits)
+	}
+}
@@ -59,6 +59,10 @@ type Escpos struct {
 	reverse uint8
 
 	Verbose bool
+
+	// StatusSource, if set, is consulted by Cut and Formfeed to pre-check
+	// printer readiness (paper, cover) before sending the command.
+	StatusSource StatusSource
 }
 
 // reset toggles
@@ -136,6 +140,9 @@ func (e *Escpos) Init() {
 // Cut the paper
 // \x1DVA0 => GS V A 0
 func (e *Escpos) Cut() {
+	if !e.checkStatusSource("Cut") {
+		return
+	}
 	e.Write("\x1DVA0")
 }
 
@@ -186,6 +193,9 @@ func (e *Escpos) FormfeedN(n uint8) {
 // Formfeed ...
 // 打印并进纸1行
 func (e *Escpos) Formfeed() {
+	if !e.checkStatusSource("Formfeed") {
+		return
+	}
 	e.FormfeedN(1)
 }
 
@@ -0,0 +1,50 @@
+package escpos
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestDitherToBitsNone(t *testing.T) {
+	gray := image.NewGray(image.Rect(0, 0, 2, 1))
+	gray.SetGray(0, 0, color.Gray{Y: 50})  // darker than threshold, should print
+	gray.SetGray(1, 0, color.Gray{Y: 200}) // lighter than threshold, should not print
+
+	bits := ditherToBits(gray, None, 128)
+
+	if !bits.get(0, 0) {
+		t.Error("pixel darker than threshold should be set")
+	}
+	if bits.get(1, 0) {
+		t.Error("pixel lighter than threshold should not be set")
+	}
+}
+
+func TestDitherToBitsFloydSteinbergConservesAverage(t *testing.T) {
+	// a uniform mid-gray field should dither to roughly half its pixels set,
+	// rather than all-or-nothing as a hard threshold would for borderline gray
+	const size = 16
+	gray := image.NewGray(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			gray.SetGray(x, y, color.Gray{Y: 128})
+		}
+	}
+
+	bits := ditherToBits(gray, FloydSteinberg, 128)
+
+	set := 0
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if bits.get(x, y) {
+				set++
+			}
+		}
+	}
+
+	total := size * size
+	if set < total*3/8 || set > total*5/8 {
+		t.Fatalf("FloydSteinberg set %d/%d pixels, want roughly half for uniform mid-gray input", set, total)
+	}
+}
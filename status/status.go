@@ -0,0 +1,287 @@
+// Package status talks the Printer-MIB (RFC 3805) and Host-Resources-MIB
+// over SNMP to networked ESC/POS printers, exposing paper level, cover, and
+// alert state that the escpos package's io.Writer-based transport cannot
+// observe on its own.
+package status
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+)
+
+// Printer-MIB / Host-Resources-MIB OIDs this package polls.
+const (
+	oidMarkerSuppliesLevel = "1.3.6.1.2.1.43.11.1.1.9"
+	oidAlertSeverityLevel  = "1.3.6.1.2.1.43.18.1.1.2"
+	oidAlertDescription    = "1.3.6.1.2.1.43.18.1.1.8"
+	oidAlertTrainingLevel  = "1.3.6.1.2.1.43.18.1.1.3"
+	oidDeviceStatus        = "1.3.6.1.2.1.25.3.2.1.5"
+)
+
+// AlertEntry is one row of the Printer-MIB prtAlertTable.
+type AlertEntry struct {
+	SeverityLevel int
+	Description   string
+	TrainingLevel int
+}
+
+// Event is emitted on Events() whenever a polled value transitions.
+type Event struct {
+	Type   string // "paper-out", "paper-near-end", "cover-open", "cover-closed", "alert", "device-status"
+	Detail string
+}
+
+// StatusPoller periodically polls a networked printer over SNMP and reports
+// transitions in paper, cover, and alert state.
+type StatusPoller struct {
+	conn     *gosnmp.GoSNMP
+	interval time.Duration
+
+	events chan Event
+	stop   chan struct{}
+
+	mu           sync.Mutex
+	paperLevel   int
+	coverOpen    bool
+	alerts       []AlertEntry
+	deviceStatus int
+}
+
+// Monitor connects to host:161 via SNMP community string community and
+// starts polling in the background. Call StatusPoller.Close when done.
+func Monitor(host string, community string) (*StatusPoller, error) {
+	conn := &gosnmp.GoSNMP{
+		Target:    host,
+		Port:      161,
+		Community: community,
+		Version:   gosnmp.Version2c,
+		Timeout:   2 * time.Second,
+		Retries:   1,
+	}
+	if err := conn.Connect(); err != nil {
+		return nil, fmt.Errorf("status: connect %s: %w", host, err)
+	}
+
+	p := &StatusPoller{
+		conn:     conn,
+		interval: 5 * time.Second,
+		events:   make(chan Event, 16),
+		stop:     make(chan struct{}),
+	}
+
+	if err := p.poll(); err != nil {
+		conn.Conn.Close()
+		return nil, err
+	}
+
+	go p.loop()
+	return p, nil
+}
+
+// Close stops polling and releases the SNMP connection.
+func (p *StatusPoller) Close() error {
+	close(p.stop)
+	return p.conn.Conn.Close()
+}
+
+// PaperStatus returns the last-seen prtMarkerSuppliesLevel (0-100, or a
+// negative RFC 3805 sentinel: -2 for "unknown", -3 for "some remaining").
+func (p *StatusPoller) PaperStatus() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paperLevel
+}
+
+// CoverOpen reports whether the prtAlertTable last contained a cover-related
+// alert.
+func (p *StatusPoller) CoverOpen() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.coverOpen
+}
+
+// Errors returns the last-walked prtAlertTable entries.
+func (p *StatusPoller) Errors() []AlertEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]AlertEntry, len(p.alerts))
+	copy(out, p.alerts)
+	return out
+}
+
+// Events returns a channel that receives an Event each time a polled value
+// transitions.
+func (p *StatusPoller) Events() <-chan Event {
+	return p.events
+}
+
+// Ready reports whether the printer is currently able to print, satisfying
+// escpos.StatusSource so Cut/Formfeed can pre-check readiness.
+func (p *StatusPoller) Ready() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.paperLevel == 0 {
+		return fmt.Errorf("status: out of paper")
+	}
+	if p.coverOpen {
+		return fmt.Errorf("status: cover open")
+	}
+	return nil
+}
+
+func (p *StatusPoller) loop() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.poll()
+		}
+	}
+}
+
+func (p *StatusPoller) poll() error {
+	paperLevel, err := p.getFirstInt(oidMarkerSuppliesLevel)
+	if err != nil {
+		return err
+	}
+	deviceStatus, err := p.getFirstInt(oidDeviceStatus)
+	if err != nil {
+		return err
+	}
+	alerts, err := p.walkAlerts()
+	if err != nil {
+		return err
+	}
+
+	coverOpen := alertsIndicateCoverOpen(alerts)
+
+	p.mu.Lock()
+	prevPaper, prevCover, prevDevice, prevAlerts := p.paperLevel, p.coverOpen, p.deviceStatus, p.alerts
+	p.paperLevel, p.coverOpen, p.deviceStatus, p.alerts = paperLevel, coverOpen, deviceStatus, alerts
+	p.mu.Unlock()
+
+	if paperLevel != prevPaper {
+		switch {
+		case paperLevel == 0:
+			p.emit(Event{Type: "paper-out"})
+		case paperLevel > 0 && paperLevel <= 10:
+			p.emit(Event{Type: "paper-near-end", Detail: fmt.Sprintf("%d%%", paperLevel)})
+		}
+	}
+	if coverOpen != prevCover {
+		if coverOpen {
+			p.emit(Event{Type: "cover-open"})
+		} else {
+			p.emit(Event{Type: "cover-closed"})
+		}
+	}
+	if deviceStatus != prevDevice {
+		p.emit(Event{Type: "device-status", Detail: fmt.Sprintf("%d", deviceStatus)})
+	}
+	seen := make(map[string]bool, len(prevAlerts))
+	for _, a := range prevAlerts {
+		seen[alertKey(a)] = true
+	}
+	for _, a := range alerts {
+		if !seen[alertKey(a)] {
+			p.emit(Event{Type: "alert", Detail: a.Description})
+		}
+	}
+
+	return nil
+}
+
+// alertKey identifies an AlertEntry for transition detection.
+func alertKey(a AlertEntry) string {
+	return fmt.Sprintf("%d:%d:%s", a.SeverityLevel, a.TrainingLevel, a.Description)
+}
+
+// alertsIndicateCoverOpen reports whether any current prtAlertTable entry
+// describes an open cover. prtInputStatus is bit-encoded input-tray
+// availability, not cover state, so the alert table is the documented place
+// to observe it (the cover-open condition raises its own prtAlertTable row).
+func alertsIndicateCoverOpen(alerts []AlertEntry) bool {
+	for _, a := range alerts {
+		if strings.Contains(strings.ToLower(a.Description), "cover") {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *StatusPoller) emit(e Event) {
+	select {
+	case p.events <- e:
+	default:
+		// events channel full, drop rather than block polling
+	}
+}
+
+// getFirstInt walks the table column at oid and returns the value of its
+// first row. A bare Get on a column OID (with no instance index appended)
+// returns noSuchInstance without an error, which would silently read back
+// zero for every value; walking finds the real row instance.
+func (p *StatusPoller) getFirstInt(oid string) (int, error) {
+	var vals []int
+	err := p.conn.Walk(oid, func(pdu gosnmp.SnmpPDU) error {
+		vals = append(vals, int(gosnmp.ToBigInt(pdu.Value).Int64()))
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("status: walk %s: %w", oid, err)
+	}
+	if len(vals) == 0 {
+		return 0, fmt.Errorf("status: walk %s: no rows", oid)
+	}
+	return vals[0], nil
+}
+
+func (p *StatusPoller) walkAlerts() ([]AlertEntry, error) {
+	var (
+		severities []int
+		descs      []string
+		training   []int
+	)
+
+	if err := p.conn.Walk(oidAlertSeverityLevel, func(pdu gosnmp.SnmpPDU) error {
+		severities = append(severities, int(gosnmp.ToBigInt(pdu.Value).Int64()))
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("status: walk %s: %w", oidAlertSeverityLevel, err)
+	}
+
+	if err := p.conn.Walk(oidAlertDescription, func(pdu gosnmp.SnmpPDU) error {
+		descs = append(descs, fmt.Sprintf("%s", pdu.Value))
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("status: walk %s: %w", oidAlertDescription, err)
+	}
+
+	if err := p.conn.Walk(oidAlertTrainingLevel, func(pdu gosnmp.SnmpPDU) error {
+		training = append(training, int(gosnmp.ToBigInt(pdu.Value).Int64()))
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("status: walk %s: %w", oidAlertTrainingLevel, err)
+	}
+
+	n := len(severities)
+	if len(descs) < n {
+		n = len(descs)
+	}
+	entries := make([]AlertEntry, 0, n)
+	for i := 0; i < n; i++ {
+		entry := AlertEntry{SeverityLevel: severities[i], Description: descs[i]}
+		if i < len(training) {
+			entry.TrainingLevel = training[i]
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
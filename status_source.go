@@ -0,0 +1,25 @@
+package escpos
+
+import "log"
+
+// StatusSource reports whether the printer is currently ready to print
+// (e.g. has paper and a closed cover). escpos/status.StatusPoller implements
+// this, letting Cut and Formfeed pre-check readiness on networked printers
+// whose state can't be observed through the plain io.Writer transport.
+type StatusSource interface {
+	Ready() error
+}
+
+// checkStatusSource logs and reports whether it is safe to proceed with a
+// print action, given e.StatusSource. A nil StatusSource always allows the
+// action, preserving the historical io.Writer-only behavior.
+func (e *Escpos) checkStatusSource(action string) bool {
+	if e.StatusSource == nil {
+		return true
+	}
+	if err := e.StatusSource.Ready(); err != nil {
+		log.Println(action, "skipped:", err)
+		return false
+	}
+	return true
+}
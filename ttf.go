@@ -0,0 +1,130 @@
+package escpos
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// PrintTextOptions configures PrintTextTTF.
+type PrintTextOptions struct {
+	// Align is "left", "center" or "right". Defaults to "left".
+	Align string
+
+	// WrapWidthDots wraps lines that exceed this width, in dots. 0 disables wrapping.
+	WrapWidthDots int
+
+	// LineSpacingDots is the extra vertical gap between lines, in dots.
+	LineSpacingDots int
+
+	// Threshold is the glyph alpha coverage (0-255) above which a pixel is
+	// printed black. Defaults to 128.
+	Threshold uint8
+}
+
+// PrintTextTTF renders text with a TrueType/OpenType font.Face and prints it
+// as an ESC/POS raster image, giving proper font metrics (via
+// font.MeasureString / font.Drawer) instead of the printer ROM fonts selected
+// with SetFont.
+func (e *Escpos) PrintTextTTF(face font.Face, text string, opts PrintTextOptions) error {
+	if opts.Align == "" {
+		opts.Align = "left"
+	}
+	if opts.Threshold == 0 {
+		opts.Threshold = 128
+	}
+
+	lines := strings.Split(text, "\n")
+	if opts.WrapWidthDots > 0 {
+		lines = wrapTTFLines(face, lines, opts.WrapWidthDots)
+	}
+
+	metrics := face.Metrics()
+	ascent := metrics.Ascent.Ceil()
+	lineHeight := metrics.Height.Ceil() + opts.LineSpacingDots
+
+	type measured struct {
+		line  string
+		width int
+	}
+	measuredLines := make([]measured, len(lines))
+	maxWidth := 0
+	for i, line := range lines {
+		w := font.MeasureString(face, line).Ceil()
+		measuredLines[i] = measured{line: line, width: w}
+		if w > maxWidth {
+			maxWidth = w
+		}
+	}
+	if opts.WrapWidthDots > 0 && maxWidth > opts.WrapWidthDots {
+		maxWidth = opts.WrapWidthDots
+	}
+	if maxWidth == 0 {
+		return fmt.Errorf("escpos: PrintTextTTF: nothing to render")
+	}
+
+	canvas := image.NewAlpha(image.Rect(0, 0, maxWidth, len(lines)*lineHeight))
+
+	drawer := &font.Drawer{
+		Dst:  canvas,
+		Src:  image.NewUniform(color.Alpha{A: 255}),
+		Face: face,
+	}
+
+	for i, m := range measuredLines {
+		x := 0
+		switch opts.Align {
+		case "center":
+			x = (maxWidth - m.width) / 2
+		case "right":
+			x = maxWidth - m.width
+		}
+		drawer.Dot = fixed.Point26_6{
+			X: fixed.I(x),
+			Y: fixed.I(i*lineHeight + ascent),
+		}
+		drawer.DrawString(m.line)
+	}
+
+	img := image.NewGray(canvas.Bounds())
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.Gray{Y: 255}), image.Point{}, draw.Src)
+	for y := 0; y < img.Bounds().Dy(); y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			a := canvas.AlphaAt(x, y).A
+			if a > opts.Threshold {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			}
+		}
+	}
+
+	e.PrintImage(img)
+	return nil
+}
+
+func wrapTTFLines(face font.Face, lines []string, wrapWidthDots int) []string {
+	var out []string
+	for _, line := range lines {
+		words := strings.Fields(line)
+		if len(words) == 0 {
+			out = append(out, "")
+			continue
+		}
+		cur := words[0]
+		for _, w := range words[1:] {
+			candidate := cur + " " + w
+			if font.MeasureString(face, candidate).Ceil() > wrapWidthDots {
+				out = append(out, cur)
+				cur = w
+				continue
+			}
+			cur = candidate
+		}
+		out = append(out, cur)
+	}
+	return out
+}